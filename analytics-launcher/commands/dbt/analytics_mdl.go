@@ -1,5 +1,7 @@
 package dbt
 
+import "github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt/mlcard"
+
 // AnalyticsMDLManifest represents the complete Analytics MDL structure
 type AnalyticsMDLManifest struct {
 	JsonSchema      string           `json:"$schema"`
@@ -26,13 +28,14 @@ type EnumValue struct {
 
 // AnalyticsModel represents a model in the Analytics MDL format
 type AnalyticsModel struct {
-	Name           string            `json:"name"`
-	TableReference TableReference    `json:"tableReference"`
-	Columns        []AnalyticsColumn      `json:"columns"`
-	PrimaryKey     string            `json:"primaryKey,omitempty"`
-	Cached         bool              `json:"cached,omitempty"`
-	RefreshTime    string            `json:"refreshTime,omitempty"`
-	Properties     map[string]string `json:"properties,omitempty"`
+	Name           string              `json:"name"`
+	TableReference TableReference      `json:"tableReference"`
+	Columns        []AnalyticsColumn   `json:"columns"`
+	PrimaryKey     string              `json:"primaryKey,omitempty"`
+	Cached         bool                `json:"cached,omitempty"`
+	RefreshTime    string              `json:"refreshTime,omitempty"`
+	Properties     map[string]string   `json:"properties,omitempty"`
+	ModelCard      *mlcard.MLModelCard `json:"modelCard,omitempty"`
 }
 
 // TableReference represents a reference to a table
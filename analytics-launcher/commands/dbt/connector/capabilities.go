@@ -0,0 +1,58 @@
+// Package connector exposes an AnalyticsMDLManifest over an NDC-style
+// connector protocol (https://github.com/hasura/ndc-spec), so any client
+// that already speaks the spec's capabilities/schema/query handshake can
+// query a manifest without a bespoke adapter.
+package connector
+
+// CapabilitiesVersion is the ndc-spec revision this connector implements.
+const CapabilitiesVersion = "0.1.6"
+
+// Capabilities describes the query features this connector supports, so
+// clients can negotiate which optional behaviors to rely on before issuing
+// a query.
+type Capabilities struct {
+	Version       string                   `json:"version"`
+	Query         QueryCapabilities        `json:"query"`
+	Relationships RelationshipCapabilities `json:"relationships"`
+}
+
+// QueryCapabilities enumerates the optional query-shape features a client
+// may request.
+type QueryCapabilities struct {
+	Aggregates   *AggregateCapabilities   `json:"aggregates,omitempty"`
+	NestedFields *NestedFieldCapabilities `json:"nested_fields,omitempty"`
+}
+
+// AggregateCapabilities describes support for aggregate queries, including
+// whether aggregates can carry their own predicate (aggregates.filter_by).
+type AggregateCapabilities struct {
+	FilterBy bool `json:"filter_by"`
+}
+
+// NestedFieldCapabilities describes support for selecting fields nested
+// inside relationship-joined rows.
+type NestedFieldCapabilities struct {
+	FilterBy bool `json:"filter_by"`
+	OrderBy  bool `json:"order_by"`
+}
+
+// RelationshipCapabilities describes support for comparing fields across a
+// relationship (relationships.relation_comparisons).
+type RelationshipCapabilities struct {
+	RelationComparisons bool `json:"relation_comparisons"`
+}
+
+// capabilitiesFor derives the capability set a manifest supports. Today
+// every manifest gets the same feature set; this is the extension point for
+// manifests that opt out of a feature once DataSource-specific connectors
+// exist.
+func capabilitiesFor() Capabilities {
+	return Capabilities{
+		Version: CapabilitiesVersion,
+		Query: QueryCapabilities{
+			Aggregates:   &AggregateCapabilities{FilterBy: true},
+			NestedFields: &NestedFieldCapabilities{FilterBy: true, OrderBy: true},
+		},
+		Relationships: RelationshipCapabilities{RelationComparisons: true},
+	}
+}
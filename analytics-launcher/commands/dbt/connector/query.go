@@ -0,0 +1,33 @@
+package connector
+
+import "context"
+
+// QueryRequest is an NDC-style structured query against one collection.
+type QueryRequest struct {
+	Collection string    `json:"collection"`
+	Query      QueryBody `json:"query"`
+}
+
+// QueryBody is the selection, filter, and pagination portion of a
+// QueryRequest.
+type QueryBody struct {
+	Fields     []string          `json:"fields,omitempty"`
+	Aggregates []string          `json:"aggregates,omitempty"`
+	Predicate  map[string]string `json:"predicate,omitempty"`
+	Limit      int               `json:"limit,omitempty"`
+	Offset     int               `json:"offset,omitempty"`
+}
+
+// QueryResponse is the row set (and any requested aggregates) a DataSource
+// returned for a QueryRequest.
+type QueryResponse struct {
+	Rows       []map[string]interface{} `json:"rows"`
+	Aggregates map[string]interface{}   `json:"aggregates,omitempty"`
+}
+
+// DataSource executes a QueryRequest against the backing store named by
+// AnalyticsMDLManifest.DataSource. Connectors for a specific warehouse
+// implement this to plug into Server.
+type DataSource interface {
+	Execute(ctx context.Context, req QueryRequest) (QueryResponse, error)
+}
@@ -0,0 +1,162 @@
+package connector
+
+import (
+	"strings"
+
+	"github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt"
+)
+
+// SchemaResponse is the NDC-style schema document describing the
+// collections, fields, and scalar types a manifest exposes.
+type SchemaResponse struct {
+	ScalarTypes map[string]ScalarType `json:"scalar_types"`
+	Collections []CollectionInfo      `json:"collections"`
+}
+
+// ScalarType describes a scalar exposed by the connector. Manifest enum
+// definitions become scalars with a fixed set of representations; every
+// other column type passes through as an opaque scalar.
+type ScalarType struct {
+	Representation ScalarRepresentation `json:"representation"`
+}
+
+// ScalarRepresentation is either {"type": "enum", "one_of": [...]}  for a
+// manifest enum, or {"type": <name>} for any other column type.
+type ScalarRepresentation struct {
+	Type  string   `json:"type"`
+	OneOf []string `json:"one_of,omitempty"`
+}
+
+// CollectionInfo is one queryable collection: a manifest model, its fields,
+// and the foreign-key-shaped relationships reachable from it.
+type CollectionInfo struct {
+	Name        string                          `json:"name"`
+	PrimaryKey  []string                        `json:"primary_key,omitempty"`
+	Fields      map[string]FieldInfo            `json:"fields"`
+	ForeignKeys map[string]ForeignKeyConstraint `json:"foreign_keys,omitempty"`
+}
+
+// FieldInfo is a single field on a collection and the scalar type it holds.
+type FieldInfo struct {
+	Type string `json:"type"`
+}
+
+// ForeignKeyConstraint describes a relationship as a join from this
+// collection's columns to another collection's columns.
+type ForeignKeyConstraint struct {
+	ForeignCollection string            `json:"foreign_collection"`
+	ColumnMapping     map[string]string `json:"column_mapping"`
+}
+
+// BuildSchema translates manifest into an NDC-style SchemaResponse: models
+// become collections, columns become fields, relationships become foreign
+// keys, and enum definitions become scalar types with explicit
+// representations.
+func BuildSchema(manifest *dbt.AnalyticsMDLManifest) SchemaResponse {
+	scalars := map[string]ScalarType{}
+	for _, enum := range manifest.EnumDefinitions {
+		oneOf := make([]string, 0, len(enum.Values))
+		for _, v := range enum.Values {
+			oneOf = append(oneOf, v.Name)
+		}
+		scalars[enum.Name] = ScalarType{Representation: ScalarRepresentation{Type: "enum", OneOf: oneOf}}
+	}
+
+	collections := make([]CollectionInfo, 0, len(manifest.Models))
+	for _, model := range manifest.Models {
+		fields := make(map[string]FieldInfo, len(model.Columns))
+		for _, col := range model.Columns {
+			typeName := strings.TrimPrefix(col.Type, "enum:")
+			fields[col.Name] = FieldInfo{Type: typeName}
+			if _, ok := scalars[typeName]; !ok {
+				scalars[typeName] = ScalarType{Representation: ScalarRepresentation{Type: typeName}}
+			}
+		}
+
+		info := CollectionInfo{
+			Name:   model.Name,
+			Fields: fields,
+		}
+		if model.PrimaryKey != "" {
+			info.PrimaryKey = []string{model.PrimaryKey}
+		}
+		if fks := foreignKeysFor(model.Name, manifest.Relationships); len(fks) > 0 {
+			info.ForeignKeys = fks
+		}
+		collections = append(collections, info)
+	}
+
+	return SchemaResponse{ScalarTypes: scalars, Collections: collections}
+}
+
+// collectionByName looks up a collection by name, for request validation.
+func (s SchemaResponse) collectionByName(name string) (CollectionInfo, bool) {
+	for _, c := range s.Collections {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return CollectionInfo{}, false
+}
+
+// foreignKeysFor returns the foreign key constraints implied by every
+// two-model relationship whose first model is modelName; relationships that
+// list modelName second yield no foreign key on modelName.
+func foreignKeysFor(modelName string, relationships []dbt.Relationship) map[string]ForeignKeyConstraint {
+	fks := map[string]ForeignKeyConstraint{}
+	for _, rel := range relationships {
+		if len(rel.Models) != 2 {
+			continue
+		}
+		if rel.Models[0] != modelName {
+			continue
+		}
+
+		mapping := map[string]string{}
+		if localCol, foreignCol, ok := parseJoinCondition(rel.Condition, rel.Models[0], rel.Models[1]); ok {
+			mapping[localCol] = foreignCol
+		}
+
+		fks[rel.Name] = ForeignKeyConstraint{
+			ForeignCollection: rel.Models[1],
+			ColumnMapping:     mapping,
+		}
+	}
+	return fks
+}
+
+// parseJoinCondition parses a Relationship.Condition of the form
+// "localModel.column = foreignModel.column" (in either operand order) into
+// the local and foreign column names. It returns ok=false for any
+// condition it can't confidently attribute to localModel and foreignModel,
+// so callers can fall back to an empty mapping instead of fabricating one.
+func parseJoinCondition(condition, localModel, foreignModel string) (localCol, foreignCol string, ok bool) {
+	left, right, hasEquals := strings.Cut(condition, "=")
+	if !hasEquals {
+		return "", "", false
+	}
+
+	leftModel, leftCol, leftOK := splitQualifiedColumn(left)
+	rightModel, rightCol, rightOK := splitQualifiedColumn(right)
+	if !leftOK || !rightOK {
+		return "", "", false
+	}
+
+	switch {
+	case leftModel == localModel && rightModel == foreignModel:
+		return leftCol, rightCol, true
+	case rightModel == localModel && leftModel == foreignModel:
+		return rightCol, leftCol, true
+	default:
+		return "", "", false
+	}
+}
+
+// splitQualifiedColumn splits a "model.column" operand into its two parts.
+func splitQualifiedColumn(operand string) (model, column string, ok bool) {
+	model, column, found := strings.Cut(strings.TrimSpace(operand), ".")
+	if !found {
+		return "", "", false
+	}
+	return strings.TrimSpace(model), strings.TrimSpace(column), true
+}
@@ -0,0 +1,82 @@
+package connector
+
+import (
+	"testing"
+
+	"github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt"
+)
+
+func TestForeignKeysForParsesJoinCondition(t *testing.T) {
+	relationships := []dbt.Relationship{
+		{
+			Name:      "orders_to_customers",
+			Models:    []string{"orders", "customers"},
+			JoinType:  "MANY_TO_ONE",
+			Condition: "orders.customer_id = customers.id",
+		},
+	}
+
+	fks := foreignKeysFor("orders", relationships)
+	fk, ok := fks["orders_to_customers"]
+	if !ok {
+		t.Fatalf("expected a foreign key for orders_to_customers, got: %v", fks)
+	}
+	if fk.ForeignCollection != "customers" {
+		t.Errorf("expected foreign collection customers, got: %s", fk.ForeignCollection)
+	}
+	if fk.ColumnMapping["customer_id"] != "id" {
+		t.Errorf("expected column_mapping {customer_id: id}, got: %v", fk.ColumnMapping)
+	}
+}
+
+func TestForeignKeysForHandlesReversedOperandOrder(t *testing.T) {
+	relationships := []dbt.Relationship{
+		{
+			Name:      "orders_to_customers",
+			Models:    []string{"orders", "customers"},
+			JoinType:  "MANY_TO_ONE",
+			Condition: "customers.id = orders.customer_id",
+		},
+	}
+
+	fks := foreignKeysFor("orders", relationships)
+	if fks["orders_to_customers"].ColumnMapping["customer_id"] != "id" {
+		t.Errorf("expected column_mapping {customer_id: id}, got: %v", fks["orders_to_customers"].ColumnMapping)
+	}
+}
+
+func TestForeignKeysForLeavesMappingEmptyOnUnparseableCondition(t *testing.T) {
+	relationships := []dbt.Relationship{
+		{
+			Name:      "orders_to_customers",
+			Models:    []string{"orders", "customers"},
+			JoinType:  "MANY_TO_ONE",
+			Condition: "customer_id == id",
+		},
+	}
+
+	fks := foreignKeysFor("orders", relationships)
+	if len(fks["orders_to_customers"].ColumnMapping) != 0 {
+		t.Errorf("expected empty column_mapping for an unparseable condition, got: %v", fks["orders_to_customers"].ColumnMapping)
+	}
+}
+
+func TestBuildSchemaExposesEnumScalars(t *testing.T) {
+	manifest := &dbt.AnalyticsMDLManifest{
+		EnumDefinitions: []dbt.EnumDefinition{
+			{Name: "order_status", Values: []dbt.EnumValue{{Name: "OPEN"}, {Name: "CLOSED"}}},
+		},
+		Models: []dbt.AnalyticsModel{
+			{Name: "orders", Columns: []dbt.AnalyticsColumn{{Name: "status", Type: "order_status"}}},
+		},
+	}
+
+	schema := BuildSchema(manifest)
+	scalar, ok := schema.ScalarTypes["order_status"]
+	if !ok {
+		t.Fatalf("expected a scalar type for order_status, got: %v", schema.ScalarTypes)
+	}
+	if scalar.Representation.Type != "enum" {
+		t.Errorf("expected representation type enum, got: %s", scalar.Representation.Type)
+	}
+}
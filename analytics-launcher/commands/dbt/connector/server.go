@@ -0,0 +1,88 @@
+package connector
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt"
+)
+
+// Server exposes an AnalyticsMDLManifest over the ndc-spec HTTP protocol:
+// GET /capabilities, GET /schema, and POST /query.
+type Server struct {
+	manifest *dbt.AnalyticsMDLManifest
+	source   DataSource
+	schema   SchemaResponse
+}
+
+// NewServer builds a Server for manifest, executing queries against source.
+func NewServer(manifest *dbt.AnalyticsMDLManifest, source DataSource) *Server {
+	return &Server{
+		manifest: manifest,
+		source:   source,
+		schema:   BuildSchema(manifest),
+	}
+}
+
+// Handler returns an http.Handler serving the three ndc-spec endpoints.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/capabilities", s.handleCapabilities)
+	mux.HandleFunc("/schema", s.handleSchema)
+	mux.HandleFunc("/query", s.handleQuery)
+	return mux
+}
+
+func (s *Server) handleCapabilities(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, capabilitiesFor())
+}
+
+func (s *Server) handleSchema(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.schema)
+}
+
+func (s *Server) handleQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req QueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("decoding query request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := s.schema.collectionByName(req.Collection); !ok {
+		http.Error(w, fmt.Sprintf("unknown collection %q", req.Collection), http.StatusBadRequest)
+		return
+	}
+
+	if s.source == nil {
+		http.Error(w, "no data source configured", http.StatusServiceUnavailable)
+		return
+	}
+
+	resp, err := s.source.Execute(r.Context(), req)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("executing query: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
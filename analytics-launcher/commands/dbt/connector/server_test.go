@@ -0,0 +1,63 @@
+package connector
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt"
+)
+
+func testManifest() *dbt.AnalyticsMDLManifest {
+	return &dbt.AnalyticsMDLManifest{
+		Models: []dbt.AnalyticsModel{
+			{Name: "orders", Columns: []dbt.AnalyticsColumn{{Name: "id", Type: "INTEGER"}}},
+		},
+	}
+}
+
+func TestHandleQueryWithoutDataSourceReturns503(t *testing.T) {
+	srv := NewServer(testManifest(), nil)
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"collection":"orders","query":{}}`))
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+}
+
+type stubDataSource struct {
+	resp QueryResponse
+}
+
+func (s stubDataSource) Execute(ctx context.Context, req QueryRequest) (QueryResponse, error) {
+	return s.resp, nil
+}
+
+func TestHandleQueryUnknownCollectionReturns400(t *testing.T) {
+	srv := NewServer(testManifest(), stubDataSource{})
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"collection":"missing","query":{}}`))
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestHandleQueryExecutesAgainstDataSource(t *testing.T) {
+	srv := NewServer(testManifest(), stubDataSource{resp: QueryResponse{Rows: []map[string]interface{}{{"id": 1}}}})
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"collection":"orders","query":{}}`))
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+}
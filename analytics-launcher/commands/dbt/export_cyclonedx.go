@@ -0,0 +1,138 @@
+package dbt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// CycloneDXOptions controls ExportCycloneDX's output.
+type CycloneDXOptions struct {
+	// SerialNumber is written as the BOM's "serialNumber". ExportCycloneDX
+	// never generates one itself, so the field is omitted when this is
+	// empty.
+	SerialNumber string
+}
+
+// cyclonedxBOM mirrors the subset of the CycloneDX 1.5 BOM schema this
+// exporter populates.
+type cyclonedxBOM struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber,omitempty"`
+	Version      int                   `json:"version"`
+	Components   []cyclonedxComponent  `json:"components"`
+	Dependencies []cyclonedxDependency `json:"dependencies,omitempty"`
+}
+
+type cyclonedxComponent struct {
+	Type               string              `json:"type"`
+	Name               string              `json:"name"`
+	BOMRef             string              `json:"bom-ref"`
+	Data               []cyclonedxDataset  `json:"data,omitempty"`
+	ExternalReferences []cyclonedxExternal `json:"externalReferences,omitempty"`
+}
+
+type cyclonedxDataset struct {
+	Type           string `json:"type"`
+	Name           string `json:"name"`
+	Classification string `json:"classification,omitempty"`
+}
+
+type cyclonedxExternal struct {
+	Type    string `json:"type"`
+	URL     string `json:"url"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type cyclonedxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// ExportCycloneDX writes manifest as a CycloneDX 1.5 BOM to w: every
+// AnalyticsModel becomes a "data" component carrying a Dataset whose
+// TableReference is recorded as an external reference, and every
+// Relationship becomes a dependency edge between the models it joins.
+func (m *AnalyticsMDLManifest) ExportCycloneDX(w io.Writer, opts CycloneDXOptions) error {
+	bom := cyclonedxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: opts.SerialNumber,
+		Version:      1,
+	}
+
+	bomRef := func(modelName string) string {
+		return fmt.Sprintf("model:%s/%s", m.Schema, modelName)
+	}
+
+	for _, model := range m.Models {
+		bom.Components = append(bom.Components, cyclonedxComponent{
+			Type:   "data",
+			Name:   model.Name,
+			BOMRef: bomRef(model.Name),
+			Data: []cyclonedxDataset{{
+				Type: "dataset",
+				Name: model.Name,
+			}},
+			ExternalReferences: []cyclonedxExternal{{
+				Type:    "other",
+				URL:     tableReferenceURL(model.TableReference),
+				Comment: "tableReference",
+			}},
+		})
+	}
+
+	// Group by ref rather than emitting one dependency object per
+	// relationship: CycloneDX consumers key the dependency graph by ref, so
+	// a model with more than one relationship must contribute a single
+	// dependency object listing every model it depends on, not one
+	// duplicate-ref object per edge.
+	depIndex := map[string]int{}
+	for _, rel := range m.Relationships {
+		if len(rel.Models) != 2 {
+			continue
+		}
+		ref := bomRef(rel.Models[0])
+		dependsOn := bomRef(rel.Models[1])
+
+		if idx, ok := depIndex[ref]; ok {
+			bom.Dependencies[idx].DependsOn = append(bom.Dependencies[idx].DependsOn, dependsOn)
+			continue
+		}
+		depIndex[ref] = len(bom.Dependencies)
+		bom.Dependencies = append(bom.Dependencies, cyclonedxDependency{
+			Ref:       ref,
+			DependsOn: []string{dependsOn},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(bom); err != nil {
+		return fmt.Errorf("encoding CycloneDX BOM: %w", err)
+	}
+	return nil
+}
+
+// tableReferenceURL renders a TableReference as a dotted identifier, since
+// CycloneDX external references require a URL-shaped value.
+func tableReferenceURL(ref TableReference) string {
+	parts := []string{}
+	if ref.Catalog != "" {
+		parts = append(parts, ref.Catalog)
+	}
+	if ref.Schema != "" {
+		parts = append(parts, ref.Schema)
+	}
+	parts = append(parts, ref.Table)
+
+	url := "table://"
+	for i, p := range parts {
+		if i > 0 {
+			url += "."
+		}
+		url += p
+	}
+	return url
+}
@@ -0,0 +1,83 @@
+package dbt
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestExportCycloneDXComponentsAndDependencies(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Catalog: "analytics",
+		Schema:  "public",
+		Models: []AnalyticsModel{
+			{Name: "orders", TableReference: TableReference{Schema: "public", Table: "orders"}},
+			{Name: "customers", TableReference: TableReference{Schema: "public", Table: "customers"}},
+		},
+		Relationships: []Relationship{
+			{Name: "orders_to_customers", Models: []string{"orders", "customers"}, JoinType: "MANY_TO_ONE", Condition: "orders.customer_id = customers.id"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := manifest.ExportCycloneDX(&buf, CycloneDXOptions{SerialNumber: "urn:uuid:test"}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	var bom cyclonedxBOM
+	if err := json.Unmarshal(buf.Bytes(), &bom); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(bom.Components) != 2 {
+		t.Fatalf("expected 2 components, got: %d", len(bom.Components))
+	}
+	if len(bom.Dependencies) != 1 {
+		t.Fatalf("expected 1 dependency, got: %d", len(bom.Dependencies))
+	}
+	if bom.Dependencies[0].Ref != "model:public/orders" || bom.Dependencies[0].DependsOn[0] != "model:public/customers" {
+		t.Errorf("unexpected dependency edge: %+v", bom.Dependencies[0])
+	}
+}
+
+func TestExportCycloneDXGroupsDependenciesByRef(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Catalog: "analytics",
+		Schema:  "public",
+		Models: []AnalyticsModel{
+			{Name: "orders", TableReference: TableReference{Schema: "public", Table: "orders"}},
+			{Name: "customers", TableReference: TableReference{Schema: "public", Table: "customers"}},
+			{Name: "products", TableReference: TableReference{Schema: "public", Table: "products"}},
+		},
+		Relationships: []Relationship{
+			{Name: "orders_to_customers", Models: []string{"orders", "customers"}, JoinType: "MANY_TO_ONE", Condition: "orders.customer_id = customers.id"},
+			{Name: "orders_to_products", Models: []string{"orders", "products"}, JoinType: "MANY_TO_ONE", Condition: "orders.product_id = products.id"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := manifest.ExportCycloneDX(&buf, CycloneDXOptions{SerialNumber: "urn:uuid:test"}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	var bom cyclonedxBOM
+	if err := json.Unmarshal(buf.Bytes(), &bom); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(bom.Dependencies) != 1 {
+		t.Fatalf("expected a single grouped dependency object, got: %d: %+v", len(bom.Dependencies), bom.Dependencies)
+	}
+	dep := bom.Dependencies[0]
+	if dep.Ref != "model:public/orders" {
+		t.Errorf("unexpected dependency ref: %s", dep.Ref)
+	}
+	if len(dep.DependsOn) != 2 || dep.DependsOn[0] != "model:public/customers" || dep.DependsOn[1] != "model:public/products" {
+		t.Errorf("expected dependsOn to list both customers and products, got: %v", dep.DependsOn)
+	}
+}
+
+func TestTableReferenceURLIncludesCatalogAndSchema(t *testing.T) {
+	url := tableReferenceURL(TableReference{Catalog: "analytics", Schema: "public", Table: "orders"})
+	if url != "table://analytics.public.orders" {
+		t.Errorf("unexpected URL: %s", url)
+	}
+}
@@ -0,0 +1,150 @@
+package dbt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+// OpenLineageOptions controls ExportOpenLineage's output.
+type OpenLineageOptions struct {
+	// Namespace is the OpenLineage namespace datasets and jobs are
+	// reported under. Defaults to the manifest's catalog.schema if empty.
+	Namespace string
+	// Producer identifies the tool emitting the RunEvent, per the
+	// OpenLineage spec's required "producer" field.
+	Producer string
+	// EventTime is the RFC3339 timestamp attached to every event. Left to
+	// the caller since Manifest has no notion of "now".
+	EventTime string
+	// RunID is the OpenLineage run identifier shared by every event in the
+	// stream.
+	RunID string
+}
+
+// openLineageRunEvent mirrors the subset of the OpenLineage RunEvent schema
+// this exporter populates.
+type openLineageRunEvent struct {
+	EventType string               `json:"eventType"`
+	EventTime string               `json:"eventTime"`
+	Producer  string               `json:"producer"`
+	Run       openLineageRun       `json:"run"`
+	Job       openLineageJob       `json:"job"`
+	Inputs    []openLineageDataset `json:"inputs,omitempty"`
+	Outputs   []openLineageDataset `json:"outputs,omitempty"`
+}
+
+type openLineageRun struct {
+	RunID string `json:"runId"`
+}
+
+type openLineageJob struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type openLineageDataset struct {
+	Namespace string                 `json:"namespace"`
+	Name      string                 `json:"name"`
+	Facets    map[string]interface{} `json:"facets,omitempty"`
+}
+
+// ExportOpenLineage writes manifest as a stream of OpenLineage RunEvents to
+// w, one per line: every AnalyticsModel is a dataset, every View and Metric
+// is a job, and every two-model Relationship becomes a columnLineage facet
+// entry on the dataset of its second model, carrying the raw Condition and
+// the first model as source. A Metric's inputs
+// are its declared Models; a View has no declared model list, so its
+// inputs are every model named as a whole word in its Statement - models
+// View.Statement doesn't mention are left out rather than assuming the
+// view reads the entire manifest.
+func (m *AnalyticsMDLManifest) ExportOpenLineage(w io.Writer, opts OpenLineageOptions) error {
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = fmt.Sprintf("%s.%s", m.Catalog, m.Schema)
+	}
+
+	datasetFor := func(modelName string) openLineageDataset {
+		return openLineageDataset{Namespace: namespace, Name: modelName, Facets: columnLineageFacets(modelName, m.Relationships)}
+	}
+
+	enc := json.NewEncoder(w)
+
+	for _, view := range m.Views {
+		event := openLineageRunEvent{
+			EventType: "COMPLETE",
+			EventTime: opts.EventTime,
+			Producer:  opts.Producer,
+			Run:       openLineageRun{RunID: opts.RunID},
+			Job:       openLineageJob{Namespace: namespace, Name: view.Name},
+		}
+		for _, model := range m.Models {
+			if modelReferencedInStatement(model.Name, view.Statement) {
+				event.Inputs = append(event.Inputs, datasetFor(model.Name))
+			}
+		}
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("encoding OpenLineage event for view %q: %w", view.Name, err)
+		}
+	}
+
+	for _, metric := range m.Metrics {
+		event := openLineageRunEvent{
+			EventType: "COMPLETE",
+			EventTime: opts.EventTime,
+			Producer:  opts.Producer,
+			Run:       openLineageRun{RunID: opts.RunID},
+			Job:       openLineageJob{Namespace: namespace, Name: metric.Name},
+		}
+		for _, modelName := range metric.Models {
+			event.Inputs = append(event.Inputs, datasetFor(modelName))
+		}
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("encoding OpenLineage event for metric %q: %w", metric.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// modelNameBoundary matches a single word-boundary-delimited identifier, so
+// modelReferencedInStatement doesn't treat "orders" as referenced by a
+// statement that only mentions "order_items".
+const modelNameBoundary = `\b%s\b`
+
+// modelReferencedInStatement reports whether modelName appears as a whole
+// identifier (case-insensitively, since SQL identifiers usually are) inside
+// statement.
+func modelReferencedInStatement(modelName, statement string) bool {
+	if modelName == "" {
+		return false
+	}
+	pattern := fmt.Sprintf(modelNameBoundary, regexp.QuoteMeta(modelName))
+	matched, err := regexp.MatchString("(?i)"+pattern, statement)
+	return err == nil && matched
+}
+
+// columnLineageFacets builds the OpenLineage columnLineage facet for
+// modelName from every two-model relationship whose second model is
+// modelName, recording the relationship's first model as the source. The
+// Condition is copied as is and not inspected.
+func columnLineageFacets(modelName string, relationships []Relationship) map[string]interface{} {
+	var fields []map[string]interface{}
+	for _, rel := range relationships {
+		if len(rel.Models) != 2 || rel.Models[1] != modelName {
+			continue
+		}
+		fields = append(fields, map[string]interface{}{
+			"relationship": rel.Name,
+			"inputField":   rel.Condition,
+			"source":       rel.Models[0],
+		})
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"columnLineage": map[string]interface{}{"fields": fields},
+	}
+}
@@ -0,0 +1,87 @@
+package dbt
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestExportOpenLineageViewInputsOnlyNamedModels(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Catalog: "analytics",
+		Schema:  "public",
+		Models: []AnalyticsModel{
+			{Name: "orders", TableReference: TableReference{Table: "orders"}},
+			{Name: "customers", TableReference: TableReference{Table: "customers"}},
+		},
+		Views: []View{
+			{Name: "recent_orders", Statement: "SELECT * FROM orders WHERE created_at > now() - interval '1 day'"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := manifest.ExportOpenLineage(&buf, OpenLineageOptions{Producer: "test"}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	var event openLineageRunEvent
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(event.Inputs) != 1 || event.Inputs[0].Name != "orders" {
+		t.Errorf("expected inputs [orders], got: %v", event.Inputs)
+	}
+}
+
+func TestExportOpenLineageViewWithNoMatchingModelHasNoInputs(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Catalog: "analytics",
+		Schema:  "public",
+		Models: []AnalyticsModel{
+			{Name: "orders", TableReference: TableReference{Table: "orders"}},
+		},
+		Views: []View{
+			{Name: "static_report", Statement: "SELECT 1"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := manifest.ExportOpenLineage(&buf, OpenLineageOptions{Producer: "test"}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	var event openLineageRunEvent
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(event.Inputs) != 0 {
+		t.Errorf("expected no inputs, got: %v", event.Inputs)
+	}
+}
+
+func TestExportOpenLineageMetricInputsAreDeclaredModels(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Catalog: "analytics",
+		Schema:  "public",
+		Models: []AnalyticsModel{
+			{Name: "orders", TableReference: TableReference{Table: "orders"}},
+			{Name: "customers", TableReference: TableReference{Table: "customers"}},
+		},
+		Metrics: []Metric{
+			{Name: "order_count", Models: []string{"orders"}, Dimensions: []string{}, Aggregation: "COUNT", DisplayName: "Order Count"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := manifest.ExportOpenLineage(&buf, OpenLineageOptions{Producer: "test"}); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	var event openLineageRunEvent
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(event.Inputs) != 1 || event.Inputs[0].Name != "orders" {
+		t.Errorf("expected inputs [orders], got: %v", event.Inputs)
+	}
+}
@@ -0,0 +1,66 @@
+package dbt
+
+import "fmt"
+
+// migrationStep upgrades a manifest from one schema version to the very
+// next one. Steps are applied in sequence by Migrate, so each step only
+// needs to know about its own pair of versions.
+type migrationStep struct {
+	from, to string
+	apply    func(*AnalyticsMDLManifest)
+}
+
+// migrationPath lists every migration step in order. Migrate walks this
+// slice starting at manifest.JsonSchema, so adding a new schema version
+// only requires appending one step here.
+var migrationPath = []migrationStep{
+	{
+		from: "1.0.0",
+		to:   "1.1.0",
+		apply: func(m *AnalyticsMDLManifest) {
+			if m.DataSource == "" {
+				m.DataSource = "default"
+			}
+		},
+	},
+}
+
+// Migrate upgrades manifest in place from its current $schema version to
+// targetVersion, applying each intermediate migrationStep so the manifest is
+// valid at every version along the way. An empty targetVersion migrates to
+// CurrentSchemaVersion. It returns an error if manifest's current version,
+// or any intermediate version on the path to targetVersion, is unknown.
+func Migrate(manifest *AnalyticsMDLManifest, targetVersion string) error {
+	if targetVersion == "" {
+		targetVersion = CurrentSchemaVersion
+	}
+
+	current := manifest.JsonSchema
+	if current == "" {
+		current = "1.0.0"
+	}
+
+	if current == targetVersion {
+		manifest.JsonSchema = targetVersion
+		return nil
+	}
+
+	applied := false
+	for _, step := range migrationPath {
+		if step.from != current {
+			continue
+		}
+		step.apply(manifest)
+		manifest.JsonSchema = step.to
+		current = step.to
+		applied = true
+		if current == targetVersion {
+			return nil
+		}
+	}
+
+	if !applied {
+		return fmt.Errorf("no migration path from schema version %q", manifest.JsonSchema)
+	}
+	return fmt.Errorf("no migration path from %q to target version %q", current, targetVersion)
+}
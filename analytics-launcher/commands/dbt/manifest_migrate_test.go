@@ -0,0 +1,36 @@
+package dbt
+
+import "testing"
+
+func TestMigrateUpgradesAcrossSteps(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{JsonSchema: "1.0.0"}
+
+	if err := Migrate(manifest, "1.1.0"); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if manifest.JsonSchema != "1.1.0" {
+		t.Errorf("expected $schema 1.1.0, got: %s", manifest.JsonSchema)
+	}
+	if manifest.DataSource != "default" {
+		t.Errorf("expected migration step to set default dataSource, got: %q", manifest.DataSource)
+	}
+}
+
+func TestMigrateNoopWhenAlreadyAtTarget(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{JsonSchema: "1.1.0", DataSource: "warehouse"}
+
+	if err := Migrate(manifest, "1.1.0"); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if manifest.DataSource != "warehouse" {
+		t.Errorf("expected dataSource to be left untouched, got: %q", manifest.DataSource)
+	}
+}
+
+func TestMigrateUnknownVersionErrors(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{JsonSchema: "9.9.9"}
+
+	if err := Migrate(manifest, "1.1.0"); err == nil {
+		t.Error("expected an error for an unknown starting schema version")
+	}
+}
@@ -0,0 +1,323 @@
+package dbt
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// CurrentSchemaVersion is the schema revision written into $schema for
+// manifests produced by this version of the CLI.
+const CurrentSchemaVersion = "1.1.0"
+
+// schemaBaseURL is the $id prefix used for the embedded schema documents.
+const schemaBaseURL = "https://nexusquantum.io/schemas/analytics-mdl/"
+
+// enumTypePrefix marks an AnalyticsColumn.Type that names an
+// EnumDefinition rather than an opaque scalar type, e.g. "enum:order_status".
+// Column types without this prefix are never checked against
+// EnumDefinitions, matching connector.BuildSchema's treatment of them as
+// passthrough scalars.
+const enumTypePrefix = "enum:"
+
+// ValidationError describes a single structural problem found while
+// validating an AnalyticsMDLManifest, either by the JSON Schema or by the
+// semantic checks in checkReferences.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ManifestSchema validates AnalyticsMDLManifest documents against one of the
+// embedded, versioned JSON Schema definitions.
+type ManifestSchema struct {
+	version string
+	schema  *jsonschema.Schema
+}
+
+// LoadManifestSchema compiles the embedded JSON Schema for version. An empty
+// version selects CurrentSchemaVersion.
+func LoadManifestSchema(version string) (*ManifestSchema, error) {
+	if version == "" {
+		version = CurrentSchemaVersion
+	}
+
+	path := fmt.Sprintf("schemas/%s.json", version)
+	raw, err := schemaFS.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unknown manifest schema version %q: %w", version, err)
+	}
+
+	url := schemaBaseURL + version + ".json"
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(url, strings.NewReader(string(raw))); err != nil {
+		return nil, fmt.Errorf("loading manifest schema %q: %w", version, err)
+	}
+
+	schema, err := compiler.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("compiling manifest schema %q: %w", version, err)
+	}
+
+	return &ManifestSchema{version: version, schema: schema}, nil
+}
+
+// Version reports the schema revision this ManifestSchema validates against.
+func (s *ManifestSchema) Version() string {
+	return s.version
+}
+
+// Validate checks manifest against the JSON Schema and against the
+// structural invariants the schema itself cannot express (dangling
+// references, unknown enum types, cyclic calculated columns). It returns
+// every error found rather than stopping at the first one.
+func (s *ManifestSchema) Validate(manifest *AnalyticsMDLManifest) []ValidationError {
+	var errs []ValidationError
+
+	if jsonErrs := s.validateJSONSchema(manifest); len(jsonErrs) > 0 {
+		errs = append(errs, jsonErrs...)
+	}
+	errs = append(errs, checkReferences(manifest)...)
+
+	return errs
+}
+
+// manifestToInterface round-trips manifest through JSON so it can be
+// validated as a plain map[string]interface{}, the shape jsonschema expects.
+func manifestToInterface(manifest *AnalyticsMDLManifest) (interface{}, error) {
+	// Models, Relationships, and Views are required array properties in the
+	// schema, but a manifest built programmatically (struct literal, no
+	// relationships or views yet) leaves them nil, which marshals to JSON
+	// null rather than []. Normalize so "not populated yet" validates the
+	// same way it would once rendered through a builder that always sets
+	// these to at least an empty slice.
+	normalized := *manifest
+	if normalized.Models == nil {
+		normalized.Models = []AnalyticsModel{}
+	}
+	if normalized.Relationships == nil {
+		normalized.Relationships = []Relationship{}
+	}
+	if normalized.Views == nil {
+		normalized.Views = []View{}
+	}
+
+	raw, err := json.Marshal(&normalized)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling manifest: %w", err)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshaling manifest: %w", err)
+	}
+	return doc, nil
+}
+
+func (s *ManifestSchema) validateJSONSchema(manifest *AnalyticsMDLManifest) []ValidationError {
+	doc, err := manifestToInterface(manifest)
+	if err != nil {
+		return []ValidationError{{Path: "$", Message: err.Error()}}
+	}
+
+	if err := s.schema.Validate(doc); err != nil {
+		if verr, ok := err.(*jsonschema.ValidationError); ok {
+			return flattenValidationError(verr)
+		}
+		return []ValidationError{{Path: "$", Message: err.Error()}}
+	}
+
+	return nil
+}
+
+func flattenValidationError(verr *jsonschema.ValidationError) []ValidationError {
+	var errs []ValidationError
+	if len(verr.Causes) == 0 {
+		errs = append(errs, ValidationError{
+			Path:    verr.InstanceLocation,
+			Message: verr.Message,
+		})
+		return errs
+	}
+	for _, cause := range verr.Causes {
+		errs = append(errs, flattenValidationError(cause)...)
+	}
+	return errs
+}
+
+// checkReferences runs the structural checks the JSON Schema can't express:
+// unknown metric aggregations, relationships that point at models which
+// don't exist, column types that aren't in any enum definition when the
+// column references one, and cyclic calculated column expressions.
+func checkReferences(manifest *AnalyticsMDLManifest) []ValidationError {
+	var errs []ValidationError
+
+	modelNames := make(map[string]*AnalyticsModel, len(manifest.Models))
+	for i := range manifest.Models {
+		m := &manifest.Models[i]
+		modelNames[m.Name] = m
+	}
+
+	enumNames := make(map[string]bool, len(manifest.EnumDefinitions))
+	for _, e := range manifest.EnumDefinitions {
+		enumNames[e.Name] = true
+	}
+
+	relationshipNames := make(map[string]bool, len(manifest.Relationships))
+	for _, rel := range manifest.Relationships {
+		relationshipNames[rel.Name] = true
+		for _, m := range rel.Models {
+			if _, ok := modelNames[m]; !ok {
+				errs = append(errs, ValidationError{
+					Path:    fmt.Sprintf("relationships/%s/models", rel.Name),
+					Message: fmt.Sprintf("references unknown model %q", m),
+				})
+			}
+		}
+	}
+
+	for _, metric := range manifest.Metrics {
+		if !validAggregations[metric.Aggregation] {
+			errs = append(errs, ValidationError{
+				Path:    fmt.Sprintf("metrics/%s/aggregation", metric.Name),
+				Message: fmt.Sprintf("unknown aggregation type %q", metric.Aggregation),
+			})
+		}
+		for _, m := range metric.Models {
+			if _, ok := modelNames[m]; !ok {
+				errs = append(errs, ValidationError{
+					Path:    fmt.Sprintf("metrics/%s/models", metric.Name),
+					Message: fmt.Sprintf("references unknown model %q", m),
+				})
+			}
+		}
+	}
+
+	for _, model := range manifest.Models {
+		for _, col := range model.Columns {
+			if col.Relationship != "" {
+				if !relationshipNames[col.Relationship] {
+					errs = append(errs, ValidationError{
+						Path:    fmt.Sprintf("models/%s/columns/%s/relationship", model.Name, col.Name),
+						Message: fmt.Sprintf("references unknown relationship %q", col.Relationship),
+					})
+				}
+			}
+			if strings.HasPrefix(col.Type, enumTypePrefix) {
+				enumName := strings.TrimPrefix(col.Type, enumTypePrefix)
+				if !enumNames[enumName] {
+					errs = append(errs, ValidationError{
+						Path:    fmt.Sprintf("models/%s/columns/%s/type", model.Name, col.Name),
+						Message: fmt.Sprintf("references unknown enum %q", enumName),
+					})
+				}
+			}
+		}
+
+		if cycle := findCalculatedColumnCycle(model); cycle != nil {
+			errs = append(errs, ValidationError{
+				Path:    fmt.Sprintf("models/%s/columns", model.Name),
+				Message: fmt.Sprintf("cyclic calculated column expression: %s", strings.Join(cycle, " -> ")),
+			})
+		}
+	}
+
+	return errs
+}
+
+var validAggregations = map[string]bool{
+	"SUM":            true,
+	"AVG":            true,
+	"COUNT":          true,
+	"COUNT_DISTINCT": true,
+	"MIN":            true,
+	"MAX":            true,
+}
+
+// findCalculatedColumnCycle walks the dependency graph formed by calculated
+// columns referencing other column names in their Expression, returning the
+// first cycle found as an ordered list of column names, or nil if the model
+// has none.
+func findCalculatedColumnCycle(model AnalyticsModel) []string {
+	columnsByName := make(map[string]AnalyticsColumn, len(model.Columns))
+	for _, col := range model.Columns {
+		columnsByName[col.Name] = col
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(model.Columns))
+
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			cycleStart := 0
+			for i, n := range path {
+				if n == name {
+					cycleStart = i
+					break
+				}
+			}
+			return append(append([]string{}, path[cycleStart:]...), name)
+		}
+
+		col, ok := columnsByName[name]
+		if !ok || !col.IsCalculated || col.Expression == nil {
+			state[name] = visited
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for dep := range referencedColumns(*col.Expression, columnsByName) {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, col := range model.Columns {
+		if cycle := visit(col.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// identifierPattern matches a single identifier token in a calculated
+// column expression, so referencedColumns can tell "tax" apart from the
+// "tax" inside "extaxrate" instead of doing a raw substring search.
+var identifierPattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// referencedColumns returns the set of column names in columnsByName that
+// appear as identifier tokens inside expr.
+func referencedColumns(expr string, columnsByName map[string]AnalyticsColumn) map[string]bool {
+	refs := make(map[string]bool)
+	for _, token := range identifierPattern.FindAllString(expr, -1) {
+		if _, ok := columnsByName[token]; ok {
+			refs[token] = true
+		}
+	}
+	return refs
+}
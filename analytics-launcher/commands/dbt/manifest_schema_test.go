@@ -0,0 +1,96 @@
+package dbt
+
+import "testing"
+
+func baseManifestForReferenceChecks() *AnalyticsMDLManifest {
+	return &AnalyticsMDLManifest{
+		Catalog: "analytics",
+		Schema:  "public",
+		Models: []AnalyticsModel{
+			{
+				Name:           "orders",
+				TableReference: TableReference{Table: "orders"},
+				Columns: []AnalyticsColumn{
+					{Name: "id", Type: "INTEGER"},
+				},
+			},
+		},
+	}
+}
+
+func TestCheckReferencesFlagsUnknownEnumType(t *testing.T) {
+	manifest := baseManifestForReferenceChecks()
+	manifest.Models[0].Columns = append(manifest.Models[0].Columns, AnalyticsColumn{
+		Name: "status",
+		Type: "enum:nonexistent_enum",
+	})
+
+	errs := checkReferences(manifest)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Path != "models/orders/columns/status/type" {
+		t.Errorf("unexpected error path: %s", errs[0].Path)
+	}
+}
+
+func TestCheckReferencesAllowsKnownEnumType(t *testing.T) {
+	manifest := baseManifestForReferenceChecks()
+	manifest.EnumDefinitions = []EnumDefinition{
+		{Name: "order_status", Values: []EnumValue{{Name: "OPEN"}, {Name: "CLOSED"}}},
+	}
+	manifest.Models[0].Columns = append(manifest.Models[0].Columns, AnalyticsColumn{
+		Name: "status",
+		Type: "enum:order_status",
+	})
+
+	if errs := checkReferences(manifest); len(errs) != 0 {
+		t.Errorf("expected no errors, got: %v", errs)
+	}
+}
+
+func TestValidateAcceptsManifestWithoutRelationshipsOrViews(t *testing.T) {
+	manifest := baseManifestForReferenceChecks()
+	manifest.DataSource = "postgres"
+
+	schema, err := LoadManifestSchema("")
+	if err != nil {
+		t.Fatalf("loading manifest schema: %v", err)
+	}
+
+	if errs := schema.Validate(manifest); len(errs) != 0 {
+		t.Errorf("expected no errors for a manifest with nil Relationships/Views, got: %v", errs)
+	}
+}
+
+func TestFindCalculatedColumnCycleIgnoresSubstringMatches(t *testing.T) {
+	aExpr := "extaxrate"
+	taxExpr := "some_a_value"
+	model := AnalyticsModel{
+		Name: "orders",
+		Columns: []AnalyticsColumn{
+			{Name: "a", Type: "DECIMAL", IsCalculated: true, Expression: &aExpr},
+			{Name: "tax", Type: "DECIMAL", IsCalculated: true, Expression: &taxExpr},
+		},
+	}
+
+	if cycle := findCalculatedColumnCycle(model); cycle != nil {
+		t.Errorf("expected no cycle from unrelated expressions, got: %v", cycle)
+	}
+}
+
+func TestFindCalculatedColumnCycleDetectsRealCycle(t *testing.T) {
+	aExpr := "b + 1"
+	bExpr := "a + 1"
+	model := AnalyticsModel{
+		Name: "orders",
+		Columns: []AnalyticsColumn{
+			{Name: "a", Type: "DECIMAL", IsCalculated: true, Expression: &aExpr},
+			{Name: "b", Type: "DECIMAL", IsCalculated: true, Expression: &bExpr},
+		},
+	}
+
+	if cycle := findCalculatedColumnCycle(model); cycle == nil {
+		t.Error("expected a cycle to be detected")
+	}
+}
@@ -0,0 +1,54 @@
+// Package mlcard defines governance metadata for machine-learning models
+// referenced from the Analytics MDL manifest. The shape mirrors the
+// CycloneDX ML-BOM "modelCard" component data so the same document can be
+// fed into SBOM tooling without translation.
+package mlcard
+
+// MLModelCard documents the provenance, parameters, and known limitations of
+// a machine-learning model backing an AnalyticsModel.
+type MLModelCard struct {
+	ModelParameters      *ModelParameters      `json:"modelParameters,omitempty"`
+	QuantitativeAnalysis *QuantitativeAnalysis `json:"quantitativeAnalysis,omitempty"`
+	Considerations       *Considerations       `json:"considerations,omitempty"`
+	Properties           map[string]string     `json:"properties,omitempty"`
+}
+
+// ModelParameters describes how a model was trained: its task, architecture,
+// and the datasets involved.
+type ModelParameters struct {
+	Approach           string    `json:"approach,omitempty"`
+	Task               string    `json:"task,omitempty"`
+	ArchitectureFamily string    `json:"architectureFamily,omitempty"`
+	ModelArchitecture  string    `json:"modelArchitecture,omitempty"`
+	Datasets           []Dataset `json:"datasets,omitempty"`
+}
+
+// Dataset identifies a dataset used to train, validate, or test a model.
+type Dataset struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Governance  string `json:"governance,omitempty"`
+}
+
+// QuantitativeAnalysis captures the performance metrics a model was
+// evaluated against.
+type QuantitativeAnalysis struct {
+	PerformanceMetrics []PerformanceMetric `json:"performanceMetrics,omitempty"`
+}
+
+// PerformanceMetric is a single named metric and its measured value, e.g.
+// {"type": "accuracy", "value": "0.94"}.
+type PerformanceMetric struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Slice string `json:"slice,omitempty"`
+}
+
+// Considerations records the known tradeoffs, risks, and ethical
+// considerations a model's governance review surfaced.
+type Considerations struct {
+	Users                 []string `json:"users,omitempty"`
+	UseCases              []string `json:"useCases,omitempty"`
+	TechnicalLimitations  []string `json:"technicalLimitations,omitempty"`
+	EthicalConsiderations []string `json:"ethicalConsiderations,omitempty"`
+}
@@ -0,0 +1,27 @@
+package mlcard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Write encodes card as indented JSON, the format used for standalone
+// modelcard.json files.
+func Write(w io.Writer, card *MLModelCard) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(card); err != nil {
+		return fmt.Errorf("encoding model card: %w", err)
+	}
+	return nil
+}
+
+// Read decodes a standalone modelcard.json document.
+func Read(r io.Reader) (*MLModelCard, error) {
+	var card MLModelCard
+	if err := json.NewDecoder(r).Decode(&card); err != nil {
+		return nil, fmt.Errorf("decoding model card: %w", err)
+	}
+	return &card, nil
+}
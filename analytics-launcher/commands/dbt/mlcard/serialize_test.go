@@ -0,0 +1,45 @@
+package mlcard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	card := &MLModelCard{
+		ModelParameters: &ModelParameters{
+			Task:               "classification",
+			ArchitectureFamily: "transformer",
+			Datasets:           []Dataset{{Name: "training_set", Governance: "internal"}},
+		},
+		QuantitativeAnalysis: &QuantitativeAnalysis{
+			PerformanceMetrics: []PerformanceMetric{{Type: "accuracy", Value: "0.94"}},
+		},
+		Considerations: &Considerations{
+			Users: []string{"analysts"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, card); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if got.ModelParameters.Task != "classification" {
+		t.Errorf("expected task classification, got: %s", got.ModelParameters.Task)
+	}
+	if got.QuantitativeAnalysis.PerformanceMetrics[0].Value != "0.94" {
+		t.Errorf("expected accuracy 0.94, got: %v", got.QuantitativeAnalysis.PerformanceMetrics)
+	}
+}
+
+func TestReadRejectsMalformedJSON(t *testing.T) {
+	if _, err := Read(strings.NewReader("{not json")); err == nil {
+		t.Error("expected an error decoding malformed JSON")
+	}
+}
@@ -0,0 +1,22 @@
+package dbt
+
+import "github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt/mlcard"
+
+// ModelCardEntry pairs a model card with the name of the model it documents.
+type ModelCardEntry struct {
+	ModelName string
+	Card      *mlcard.MLModelCard
+}
+
+// ModelCards walks the manifest's models and returns an entry for every one
+// that carries a ModelCard, in manifest order.
+func (m *AnalyticsMDLManifest) ModelCards() []ModelCardEntry {
+	var cards []ModelCardEntry
+	for _, model := range m.Models {
+		if model.ModelCard == nil {
+			continue
+		}
+		cards = append(cards, ModelCardEntry{ModelName: model.Name, Card: model.ModelCard})
+	}
+	return cards
+}
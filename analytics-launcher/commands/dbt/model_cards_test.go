@@ -0,0 +1,34 @@
+package dbt
+
+import (
+	"testing"
+
+	"github.com/NexusQuantum/NQRust-Analytics/analytics-launcher/commands/dbt/mlcard"
+)
+
+func TestModelCardsReturnsOnlyModelsWithACard(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Models: []AnalyticsModel{
+			{Name: "orders"},
+			{Name: "fraud_score", ModelCard: &mlcard.MLModelCard{ModelParameters: &mlcard.ModelParameters{Task: "classification"}}},
+		},
+	}
+
+	cards := manifest.ModelCards()
+	if len(cards) != 1 {
+		t.Fatalf("expected 1 card, got: %d", len(cards))
+	}
+	if cards[0].ModelName != "fraud_score" {
+		t.Errorf("expected fraud_score, got: %s", cards[0].ModelName)
+	}
+}
+
+func TestModelCardsReturnsNilWithoutAnyCards(t *testing.T) {
+	manifest := &AnalyticsMDLManifest{
+		Models: []AnalyticsModel{{Name: "orders"}},
+	}
+
+	if cards := manifest.ModelCards(); cards != nil {
+		t.Errorf("expected nil, got: %v", cards)
+	}
+}
@@ -0,0 +1,305 @@
+// Package utils holds small, shared helpers used across the analytics
+// launcher CLI commands.
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rcFileName is the name of the rc file written inside an AnalyticsRC's
+// directory.
+const rcFileName = ".analyticsrc"
+
+// sensitivePrefix marks a value that was written with sensitive=true so
+// Read can tell it apart from a plain value that happens to contain "=".
+const sensitivePrefix = "b64:"
+
+// rootSection is the section name Set/Read operate on, so flat keys and
+// namespaced keys share the same storage.
+const rootSection = ""
+
+// AnalyticsRC reads and writes the analytics launcher's per-directory rc
+// file. A zero-value AnalyticsRC{dir} is ready to use: concurrency control
+// is keyed off dir rather than stored on the struct, so every AnalyticsRC
+// pointed at the same directory - in this process or another - serializes
+// through the same lock.
+type AnalyticsRC struct {
+	dir string
+}
+
+// path returns the rc file's location on disk.
+func (w AnalyticsRC) path() string {
+	return filepath.Join(w.dir, rcFileName)
+}
+
+// Set writes key=value into the default section. sensitive marks the value
+// for obscured (base64) on-disk storage; it's read back transparently.
+//
+// This is a thin wrapper over SetIn kept for backward compatibility.
+func (w AnalyticsRC) Set(key, value string, sensitive bool) error {
+	return w.SetIn(rootSection, key, value, sensitive)
+}
+
+// Read returns the default section's value for key, or "" if it isn't set.
+//
+// This is a thin wrapper over ReadIn kept for backward compatibility.
+func (w AnalyticsRC) Read(key string) (string, error) {
+	return w.ReadIn(rootSection, key)
+}
+
+// SetIn writes section.key = value, creating the rc file and its directory
+// if needed. The write is performed under an exclusive lock and is atomic:
+// the new contents are written to a temp file and renamed over the rc file,
+// so a reader never observes a partially written file.
+func (w AnalyticsRC) SetIn(section, key, value string, sensitive bool) error {
+	unlock, err := lockFile(w.path())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	doc, err := readDoc(w.path())
+	if err != nil {
+		return err
+	}
+
+	if doc[section] == nil {
+		doc[section] = map[string]string{}
+	}
+	if sensitive {
+		value = sensitivePrefix + base64.StdEncoding.EncodeToString([]byte(value))
+	}
+	doc[section][key] = value
+
+	return writeDocAtomic(w.dir, w.path(), doc)
+}
+
+// ReadIn returns section.key's value, or "" if the section or key isn't
+// set.
+func (w AnalyticsRC) ReadIn(section, key string) (string, error) {
+	unlock, err := rLockFile(w.path())
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	doc, err := readDoc(w.path())
+	if err != nil {
+		return "", err
+	}
+
+	raw := doc[section][key]
+	if strings.HasPrefix(raw, sensitivePrefix) {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(raw, sensitivePrefix))
+		if err != nil {
+			return "", fmt.Errorf("decoding sensitive value for %q: %w", key, err)
+		}
+		return string(decoded), nil
+	}
+	return raw, nil
+}
+
+// ReadBool reads section.key as a bool. An unset value returns false; an
+// unparsable value returns false and the parse error.
+func (w AnalyticsRC) ReadBool(section, key string) (bool, error) {
+	raw, err := w.ReadIn(section, key)
+	if err != nil || raw == "" {
+		return false, err
+	}
+	return strconv.ParseBool(raw)
+}
+
+// ReadInt reads section.key as an int. An unset value returns 0.
+func (w AnalyticsRC) ReadInt(section, key string) (int, error) {
+	raw, err := w.ReadIn(section, key)
+	if err != nil || raw == "" {
+		return 0, err
+	}
+	return strconv.Atoi(raw)
+}
+
+// ReadDuration reads section.key as a time.Duration (e.g. "30s"). An unset
+// value returns 0.
+func (w AnalyticsRC) ReadDuration(section, key string) (time.Duration, error) {
+	raw, err := w.ReadIn(section, key)
+	if err != nil || raw == "" {
+		return 0, err
+	}
+	return time.ParseDuration(raw)
+}
+
+// Delete removes key from the default section.
+func (w AnalyticsRC) Delete(key string) error {
+	return w.DeleteIn(rootSection, key)
+}
+
+// DeleteIn removes section.key. If the key isn't set, the stored values are
+// left unchanged, but the rc file is still rewritten (and created, along
+// with its directory, if missing).
+func (w AnalyticsRC) DeleteIn(section, key string) error {
+	unlock, err := lockFile(w.path())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	doc, err := readDoc(w.path())
+	if err != nil {
+		return err
+	}
+
+	delete(doc[section], key)
+	return writeDocAtomic(w.dir, w.path(), doc)
+}
+
+// List returns every key whose fully-qualified dotted name (section+"."+key,
+// or just key for the default section) starts with prefix, along with its
+// decoded value.
+func (w AnalyticsRC) List(prefix string) (map[string]string, error) {
+	unlock, err := rLockFile(w.path())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	doc, err := readDoc(w.path())
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]string{}
+	for section, kvs := range doc {
+		for key, raw := range kvs {
+			full := key
+			if section != rootSection {
+				full = section + "." + key
+			}
+			if !strings.HasPrefix(full, prefix) {
+				continue
+			}
+			if strings.HasPrefix(raw, sensitivePrefix) {
+				decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(raw, sensitivePrefix))
+				if err != nil {
+					return nil, fmt.Errorf("decoding sensitive value for %q: %w", full, err)
+				}
+				raw = string(decoded)
+			}
+			out[full] = raw
+		}
+	}
+	return out, nil
+}
+
+// rcDoc is the in-memory shape of the rc file: section name (rootSection
+// for the unnamed default section) to its key/value pairs.
+type rcDoc map[string]map[string]string
+
+// readDoc reads and parses the rc file at path. A missing file parses as an
+// empty document rather than an error, matching the original Read
+// behavior of returning "" for a file that hasn't been written yet.
+func readDoc(path string) (rcDoc, error) {
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return rcDoc{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading rc file %q: %w", path, err)
+	}
+	return parseDoc(raw), nil
+}
+
+// parseDoc parses the rc file's line-oriented "[section]" / "key=value"
+// format.
+func parseDoc(raw []byte) rcDoc {
+	doc := rcDoc{}
+	section := rootSection
+
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		if doc[section] == nil {
+			doc[section] = map[string]string{}
+		}
+		doc[section][key] = value
+	}
+	return doc
+}
+
+// renderDoc serializes doc back into the rc file's text format, with the
+// default section first and everything else sorted by section name for a
+// stable, diffable file.
+func renderDoc(doc rcDoc) []byte {
+	var b strings.Builder
+
+	writeSection := func(section string) {
+		keys := make([]string, 0, len(doc[section]))
+		for k := range doc[section] {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s=%s\n", k, doc[section][k])
+		}
+	}
+
+	writeSection(rootSection)
+
+	sections := make([]string, 0, len(doc))
+	for s := range doc {
+		if s != rootSection {
+			sections = append(sections, s)
+		}
+	}
+	sort.Strings(sections)
+	for _, s := range sections {
+		fmt.Fprintf(&b, "[%s]\n", s)
+		writeSection(s)
+	}
+
+	return []byte(b.String())
+}
+
+// writeDocAtomic writes doc to path by writing to a temp file in dir and
+// renaming it over path, so concurrent readers never see a truncated file.
+func writeDocAtomic(dir, path string, doc rcDoc) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating rc directory %q: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".analyticsrc.*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating temp rc file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once the rename below succeeds
+
+	if _, err := tmp.Write(renderDoc(doc)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing temp rc file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing temp rc file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("renaming rc file into place: %w", err)
+	}
+	return nil
+}
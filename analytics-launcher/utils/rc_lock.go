@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// inProcessLocks keyed by rc file path, since an AnalyticsRC carries no
+// lock of its own (see AnalyticsRC's doc comment): every value pointed at
+// the same path must serialize through the same *sync.RWMutex. The file
+// lock acquired by lockFile/rLockFile additionally serializes against
+// other processes.
+var (
+	inProcessLocksMu sync.Mutex
+	inProcessLocks   = map[string]*sync.RWMutex{}
+)
+
+func mutexFor(path string) *sync.RWMutex {
+	inProcessLocksMu.Lock()
+	defer inProcessLocksMu.Unlock()
+
+	mu, ok := inProcessLocks[path]
+	if !ok {
+		mu = &sync.RWMutex{}
+		inProcessLocks[path] = mu
+	}
+	return mu
+}
+
+// lockFile acquires an exclusive, cross-process lock on path's rc file for
+// the duration of a write, returning a function that releases it.
+func lockFile(path string) (func(), error) {
+	mu := mutexFor(path)
+	mu.Lock()
+
+	fl, err := newFileLock(path)
+	if err != nil {
+		mu.Unlock()
+		return nil, fmt.Errorf("locking rc file %q: %w", path, err)
+	}
+	if err := fl.Lock(); err != nil {
+		mu.Unlock()
+		return nil, fmt.Errorf("locking rc file %q: %w", path, err)
+	}
+
+	return func() {
+		_ = fl.Unlock()
+		mu.Unlock()
+	}, nil
+}
+
+// rLockFile acquires a shared, cross-process lock on path's rc file for the
+// duration of a read, returning a function that releases it. If path's
+// directory doesn't exist yet, locking is skipped entirely: there is
+// nothing on disk to race with, and readDoc already treats a missing file
+// as an empty document, so a read must stay side-effect-free rather than
+// creating the directory and a lock file just to observe "nothing here".
+func rLockFile(path string) (func(), error) {
+	if _, err := os.Stat(filepath.Dir(path)); os.IsNotExist(err) {
+		return func() {}, nil
+	}
+
+	mu := mutexFor(path)
+	mu.RLock()
+
+	fl, err := newFileLock(path)
+	if err != nil {
+		mu.RUnlock()
+		return nil, fmt.Errorf("locking rc file %q: %w", path, err)
+	}
+	if err := fl.RLock(); err != nil {
+		mu.RUnlock()
+		return nil, fmt.Errorf("locking rc file %q: %w", path, err)
+	}
+
+	return func() {
+		_ = fl.Unlock()
+		mu.RUnlock()
+	}, nil
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestSetCreatesNestedMissingDirectory(t *testing.T) {
+	dir, err := os.MkdirTemp("", "analyticsrc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	nested := filepath.Join(dir, "a", "b", "c")
+	w := AnalyticsRC{nested}
+
+	if err := w.Set("key", "value", false); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	v, err := w.Read("key")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if v != "value" {
+		t.Errorf("Expected value: value, got: %s", v)
+	}
+}
+
+func TestReadDoesNotCreateMissingDirectory(t *testing.T) {
+	dir, err := os.MkdirTemp("", "analyticsrc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	nested := filepath.Join(dir, "a", "b", "c")
+	w := AnalyticsRC{nested}
+
+	v, err := w.Read("key")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if v != "" {
+		t.Errorf("Expected value: \"\", got: %s", v)
+	}
+
+	if _, err := os.Stat(nested); !os.IsNotExist(err) {
+		t.Errorf("Read should not create %q, but it now exists (stat err: %v)", nested, err)
+	}
+}
+
+// TestConcurrentSetInDoesNotCorruptFile exercises the scenario lockFile and
+// writeDocAtomic exist for: many goroutines writing the same rc file at
+// once must never interleave into a torn write, and every key they set
+// must survive.
+func TestConcurrentSetInDoesNotCorruptFile(t *testing.T) {
+	dir, err := os.MkdirTemp("", "analyticsrc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	w := AnalyticsRC{dir}
+
+	const writers = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("key%d", i)
+			if err := w.SetIn("stress", key, fmt.Sprintf("value%d", i), false); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("concurrent SetIn failed: %v", err)
+	}
+
+	list, err := w.List("stress.")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(list) != writers {
+		t.Fatalf("expected %d keys, got %d: %v", writers, len(list), list)
+	}
+	for i := 0; i < writers; i++ {
+		full := fmt.Sprintf("stress.key%d", i)
+		if list[full] != fmt.Sprintf("value%d", i) {
+			t.Errorf("expected %s = value%d, got %q", full, i, list[full])
+		}
+	}
+}
@@ -0,0 +1,47 @@
+//go:build !windows
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// fileLock is a POSIX advisory lock on the rc file's companion .lock file,
+// held via flock(2).
+type fileLock struct {
+	f *os.File
+}
+
+// newFileLock opens (creating if needed) path+".lock" for locking. The rc
+// file itself is never opened for locking, so a concurrent atomic rename of
+// the rc file doesn't invalidate an in-flight lock. The rc file's directory
+// is created here too, since this runs before writeDocAtomic and SetIn/
+// DeleteIn promise to create it on demand.
+func newFileLock(path string) (*fileLock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("creating rc directory %q: %w", filepath.Dir(path), err)
+	}
+
+	f, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLock{f: f}, nil
+}
+
+func (l *fileLock) Lock() error {
+	return unix.Flock(int(l.f.Fd()), unix.LOCK_EX)
+}
+
+func (l *fileLock) RLock() error {
+	return unix.Flock(int(l.f.Fd()), unix.LOCK_SH)
+}
+
+func (l *fileLock) Unlock() error {
+	defer l.f.Close()
+	return unix.Flock(int(l.f.Fd()), unix.LOCK_UN)
+}
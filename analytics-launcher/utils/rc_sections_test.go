@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSetInAndReadIn(t *testing.T) {
+	dir, err := os.MkdirTemp("", "analyticsrc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	w := AnalyticsRC{dir}
+	if err := w.SetIn("profile.prod", "token", "secret-value", true); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	v, err := w.ReadIn("profile.prod", "token")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if v != "secret-value" {
+		t.Errorf("Expected value: secret-value, got: %s", v)
+	}
+}
+
+func TestDeleteAndList(t *testing.T) {
+	dir, err := os.MkdirTemp("", "analyticsrc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	w := AnalyticsRC{dir}
+	if err := w.Set("alpha", "1", false); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := w.SetIn("profile.prod", "beta", "2", false); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	list, err := w.List("profile.prod")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if list["profile.prod.beta"] != "2" {
+		t.Errorf("Expected profile.prod.beta to be 2, got: %v", list)
+	}
+
+	if err := w.Delete("alpha"); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	v, err := w.Read("alpha")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if v != "" {
+		t.Errorf("Expected deleted key to read as empty, got: %s", v)
+	}
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// EventType classifies a change reported by Watch.
+type EventType int
+
+const (
+	// EventWrite reports that the rc file was created or rewritten.
+	EventWrite EventType = iota
+	// EventRemove reports that the rc file was removed.
+	EventRemove
+)
+
+// Event is a single change to an rc file, as reported by Watch.
+type Event struct {
+	Type EventType
+}
+
+// Watch streams an Event each time the rc file is written or removed,
+// closing the channel when ctx is canceled or the watch can no longer be
+// maintained. Because writes go through writeDocAtomic's temp-file-plus-
+// rename, Watch sees a single create event per write rather than a partial
+// one.
+func (w AnalyticsRC) Watch(ctx context.Context) (<-chan Event, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(w.dir); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer watcher.Close()
+		defer close(events)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if ev.Name != w.path() {
+					continue
+				}
+				out, ok := translateEvent(ev)
+				if !ok {
+					continue
+				}
+				select {
+				case events <- out:
+				case <-ctx.Done():
+					return
+				}
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func translateEvent(ev fsnotify.Event) (Event, bool) {
+	switch {
+	case ev.Op&(fsnotify.Create|fsnotify.Write) != 0:
+		return Event{Type: EventWrite}, true
+	case ev.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+		return Event{Type: EventRemove}, true
+	default:
+		return Event{}, false
+	}
+}
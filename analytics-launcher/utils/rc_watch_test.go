@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWatchReportsWriteAndRemove(t *testing.T) {
+	dir, err := os.MkdirTemp("", "analyticsrc")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	w := AnalyticsRC{dir}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := w.Watch(ctx)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if err := w.Set("key", "value", false); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("events channel closed before a write event arrived")
+		}
+		if ev.Type != EventWrite {
+			t.Errorf("expected EventWrite, got: %v", ev.Type)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for write event")
+	}
+
+	if err := os.Remove(w.path()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("events channel closed before a remove event arrived")
+		}
+		if ev.Type != EventRemove {
+			t.Errorf("expected EventRemove, got: %v", ev.Type)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for remove event")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected events channel to close after ctx cancellation")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for events channel to close")
+	}
+}